@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// NewDiscordProvider builds a Provider that logs users in via Discord's
+// authorization-code flow.
+func NewDiscordProvider(clientID, clientSecret, redirectURL string) Provider {
+	return &oauth2Provider{
+		name: "discord",
+		config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"identify", "email"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://discord.com/api/oauth2/authorize",
+				TokenURL: "https://discord.com/api/oauth2/token",
+			},
+		},
+		userinfoURL:   "https://discord.com/api/users/@me",
+		parseUserinfo: parseDiscordUserinfo,
+	}
+}
+
+func parseDiscordUserinfo(body []byte) (*UserInfo, error) {
+	var u struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Email    string `json:"email"`
+		Avatar   string `json:"avatar"`
+	}
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("auth: discord: decoding userinfo: %w", err)
+	}
+
+	var avatarURL string
+	if u.Avatar != "" {
+		avatarURL = fmt.Sprintf("https://cdn.discordapp.com/avatars/%s/%s.png", u.ID, u.Avatar)
+	}
+
+	return &UserInfo{
+		ID:        u.ID,
+		Username:  u.Username,
+		Email:     u.Email,
+		AvatarURL: avatarURL,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// NewGitHubProvider builds a Provider that logs users in via GitHub's
+// authorization-code flow.
+func NewGitHubProvider(clientID, clientSecret, redirectURL string) Provider {
+	return &oauth2Provider{
+		name: "github",
+		config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"read:user", "user:email"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://github.com/login/oauth/authorize",
+				TokenURL: "https://github.com/login/oauth/access_token",
+			},
+		},
+		userinfoURL:   "https://api.github.com/user",
+		parseUserinfo: parseGitHubUserinfo,
+	}
+}
+
+func parseGitHubUserinfo(body []byte) (*UserInfo, error) {
+	var u struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Email     string `json:"email"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("auth: github: decoding userinfo: %w", err)
+	}
+
+	return &UserInfo{
+		ID:        fmt.Sprintf("%d", u.ID),
+		Username:  u.Login,
+		Email:     u.Email,
+		AvatarURL: u.AvatarURL,
+	}, nil
+}
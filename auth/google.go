@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/oauth2"
+)
+
+// NewGoogleProvider builds a Provider that logs users in via Google's
+// authorization-code flow.
+func NewGoogleProvider(clientID, clientSecret, redirectURL string) Provider {
+	return &oauth2Provider{
+		name: "google",
+		config: oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Scopes:       []string{"openid", "email", "profile"},
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  "https://accounts.google.com/o/oauth2/auth",
+				TokenURL: "https://oauth2.googleapis.com/token",
+			},
+		},
+		userinfoURL:   "https://www.googleapis.com/oauth2/v2/userinfo",
+		parseUserinfo: parseGoogleUserinfo,
+	}
+}
+
+func parseGoogleUserinfo(body []byte) (*UserInfo, error) {
+	var u struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		Email   string `json:"email"`
+		Picture string `json:"picture"`
+	}
+	if err := json.Unmarshal(body, &u); err != nil {
+		return nil, fmt.Errorf("auth: google: decoding userinfo: %w", err)
+	}
+
+	return &UserInfo{
+		ID:        u.ID,
+		Username:  u.Name,
+		Email:     u.Email,
+		AvatarURL: u.Picture,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+)
+
+type contextKey string
+
+const sessionContextKey contextKey = "auth.session"
+
+// CheckSession is middleware that requires a valid session cookie, rejecting
+// the request with 401 if one isn't present, and otherwise attaching the
+// decoded Session to the request context for handlers to read via
+// SessionFromContext.
+func CheckSession(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sess, err := SessionFromRequest(r)
+		if err != nil {
+			http.Error(w, "authentication required", http.StatusUnauthorized)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), sessionContextKey, sess)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// SessionFromContext retrieves the Session attached by CheckSession.
+func SessionFromContext(ctx context.Context) (*Session, bool) {
+	sess, ok := ctx.Value(sessionContextKey).(*Session)
+	return sess, ok
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// oauth2Provider is a generic Provider built on top of golang.org/x/oauth2.
+// Each concrete provider (Twitch, Discord, Google, GitHub) supplies its own
+// endpoint, scopes, and userinfo parsing.
+type oauth2Provider struct {
+	name                 string
+	config               oauth2.Config
+	userinfoURL          string
+	userinfoExtraHeaders map[string]string
+	parseUserinfo        func(body []byte) (*UserInfo, error)
+}
+
+func (p *oauth2Provider) Name() string {
+	return p.name
+}
+
+func (p *oauth2Provider) AuthURL(state string) string {
+	return p.config.AuthCodeURL(state)
+}
+
+func (p *oauth2Provider) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	token, err := p.config.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %s: exchanging code: %w", p.name, err)
+	}
+	return token, nil
+}
+
+func (p *oauth2Provider) Userinfo(ctx context.Context, token *oauth2.Token) (*UserInfo, error) {
+	client := p.config.Client(ctx, token)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.userinfoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %s: building userinfo request: %w", p.name, err)
+	}
+	for header, value := range p.userinfoExtraHeaders {
+		req.Header.Set(header, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %s: requesting userinfo: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("auth: %s: reading userinfo response: %w", p.name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("auth: %s: userinfo request failed with status %d", p.name, resp.StatusCode)
+	}
+
+	return p.parseUserinfo(body)
+}
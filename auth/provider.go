@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+)
+
+// UserInfo is the minimal profile a Provider extracts after a successful
+// authorization-code exchange.
+type UserInfo struct {
+	ID        string
+	Username  string
+	Email     string
+	AvatarURL string
+}
+
+// Provider is an OAuth2 authorization-code login provider, e.g. Twitch,
+// Discord, Google, or GitHub.
+type Provider interface {
+	// Name is the provider's identifier as used in /auth/{provider}/... routes.
+	Name() string
+
+	// AuthURL returns the provider's consent-screen URL for the given
+	// CSRF state value.
+	AuthURL(state string) string
+
+	// Exchange trades an authorization code for an access token.
+	Exchange(ctx context.Context, code string) (*oauth2.Token, error)
+
+	// Userinfo fetches the authenticated user's profile using token.
+	Userinfo(ctx context.Context, token *oauth2.Token) (*UserInfo, error)
+}
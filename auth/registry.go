@@ -0,0 +1,15 @@
+package auth
+
+// providers holds every Provider registered via Register, keyed by Name().
+var providers = map[string]Provider{}
+
+// Register adds p to the set of providers addressable by /auth/{provider}/....
+func Register(p Provider) {
+	providers[p.Name()] = p
+}
+
+// Get looks up a registered provider by name.
+func Get(name string) (Provider, bool) {
+	p, ok := providers[name]
+	return p, ok
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// sessionCookieName is the cookie a logged-in user's Session is stored under.
+const sessionCookieName = "session"
+
+// sessionDuration is how long a session cookie stays valid once issued.
+const sessionDuration = 90 * 24 * time.Hour
+
+// Session is the provider-scoped identity persisted in a user's session
+// cookie after a successful OAuth2 login.
+type Session struct {
+	Provider    string `json:"provider"`
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	AccessToken string `json:"access_token"`
+
+	// ExpiresAt is a Unix timestamp baked into the signed payload itself, so
+	// a cookie replayed outside the browser (copied from logs, a stolen
+	// backup, etc.) expires with the signature rather than being valid
+	// forever. The Cookie's own Expires is only a hint to the browser.
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// sessionSecret returns the key used to sign session cookies, or an error if
+// AUTH_SESSION_SECRET isn't configured. An empty key would make session
+// cookies trivially forgeable, so callers must not fall back to one.
+func sessionSecret() ([]byte, error) {
+	secret := os.Getenv("AUTH_SESSION_SECRET")
+	if secret == "" {
+		return nil, fmt.Errorf("auth: AUTH_SESSION_SECRET is not set")
+	}
+	return []byte(secret), nil
+}
+
+// RequireSessionSecret reports whether AUTH_SESSION_SECRET is configured,
+// so callers can fail fast at startup rather than silently issuing
+// forgeable session cookies.
+func RequireSessionSecret() error {
+	_, err := sessionSecret()
+	return err
+}
+
+// sign returns the base64-encoded HMAC-SHA256 of payload.
+func sign(payload []byte) (string, error) {
+	secret, err := sessionSecret()
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return base64.URLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// SetSessionCookie persists sess in a signed, HttpOnly, Secure, SameSite
+// cookie with a 3-month expiry, stamping sess.ExpiresAt so the expiry is
+// enforced from the signed payload rather than trusted to the browser.
+func SetSessionCookie(w http.ResponseWriter, sess *Session) error {
+	sess.ExpiresAt = time.Now().Add(sessionDuration).Unix()
+
+	payload, err := json.Marshal(sess)
+	if err != nil {
+		return fmt.Errorf("auth: marshaling session: %w", err)
+	}
+
+	sig, err := sign(payload)
+	if err != nil {
+		return err
+	}
+
+	encodedPayload := base64.URLEncoding.EncodeToString(payload)
+	value := encodedPayload + "." + sig
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    value,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Now().Add(sessionDuration),
+	})
+
+	return nil
+}
+
+// SessionFromRequest decodes and verifies the session cookie on r.
+func SessionFromRequest(r *http.Request) (*Session, error) {
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return nil, fmt.Errorf("auth: no session cookie: %w", err)
+	}
+
+	encodedPayload, sig, ok := strings.Cut(cookie.Value, ".")
+	if !ok {
+		return nil, fmt.Errorf("auth: malformed session cookie")
+	}
+
+	payload, err := base64.URLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("auth: decoding session cookie: %w", err)
+	}
+
+	wantSig, err := sign(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(sig), []byte(wantSig)) {
+		return nil, fmt.Errorf("auth: session cookie failed signature check")
+	}
+
+	var sess Session
+	if err := json.Unmarshal(payload, &sess); err != nil {
+		return nil, fmt.Errorf("auth: decoding session: %w", err)
+	}
+	if time.Now().Unix() > sess.ExpiresAt {
+		return nil, fmt.Errorf("auth: session cookie expired")
+	}
+
+	return &sess, nil
+}
+
+// ClearSessionCookie removes a previously set session cookie.
+func ClearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   -1,
+	})
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestSetAndReadSessionCookie(t *testing.T) {
+	os.Setenv("AUTH_SESSION_SECRET", "test-secret")
+
+	want := &Session{Provider: "twitch", UserID: "123", Username: "shroud", AccessToken: "tok"}
+
+	rec := httptest.NewRecorder()
+	if err := SetSessionCookie(rec, want); err != nil {
+		t.Fatalf("SetSessionCookie: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	for _, c := range rec.Result().Cookies() {
+		req.AddCookie(c)
+	}
+
+	got, err := SessionFromRequest(req)
+	if err != nil {
+		t.Fatalf("SessionFromRequest: %v", err)
+	}
+	if *got != *want {
+		t.Errorf("SessionFromRequest() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSessionFromRequestRejectsTamperedCookie(t *testing.T) {
+	os.Setenv("AUTH_SESSION_SECRET", "test-secret")
+
+	rec := httptest.NewRecorder()
+	if err := SetSessionCookie(rec, &Session{Provider: "twitch", UserID: "123"}); err != nil {
+		t.Fatalf("SetSessionCookie: %v", err)
+	}
+
+	cookies := rec.Result().Cookies()
+	cookies[0].Value = cookies[0].Value + "tampered"
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.AddCookie(cookies[0])
+
+	if _, err := SessionFromRequest(req); err == nil {
+		t.Fatal("expected tampered cookie to be rejected")
+	}
+}
+
+func TestSessionFromRequestRejectsExpiredSession(t *testing.T) {
+	os.Setenv("AUTH_SESSION_SECRET", "test-secret")
+
+	payload, err := json.Marshal(&Session{
+		Provider:  "twitch",
+		UserID:    "123",
+		ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	sig, err := sign(payload)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	value := base64.URLEncoding.EncodeToString(payload) + "." + sig
+
+	req := httptest.NewRequest(http.MethodGet, "/me", nil)
+	req.AddCookie(&http.Cookie{Name: sessionCookieName, Value: value})
+
+	if _, err := SessionFromRequest(req); err == nil {
+		t.Fatal("expected expired session cookie to be rejected")
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/larson-carter/IGDB-Wrapper/auth"
+)
+
+// oauthStateCookie is the short-lived cookie used to verify the state
+// parameter on an OAuth2 callback, preventing CSRF login forgery.
+const oauthStateCookie = "oauth_state"
+
+// handleAuth dispatches /auth/{provider}/login and /auth/{provider}/callback
+// requests to the matching registered auth.Provider.
+func handleAuth(w http.ResponseWriter, r *http.Request) {
+	providerName, action, ok := parseAuthPath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	provider, ok := auth.Get(providerName)
+	if !ok {
+		http.Error(w, "unknown auth provider: "+providerName, http.StatusNotFound)
+		return
+	}
+
+	switch action {
+	case "login":
+		handleAuthLogin(provider, w, r)
+	case "callback":
+		handleAuthCallback(provider, providerName, w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// parseAuthPath splits "/auth/{provider}/{action}" into its two components.
+func parseAuthPath(path string) (provider, action string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(path, "/auth/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+func handleAuthLogin(provider auth.Provider, w http.ResponseWriter, r *http.Request) {
+	state, err := generateState()
+	if err != nil {
+		http.Error(w, "Error generating OAuth state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthStateCookie,
+		Value:    state,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   600,
+	})
+
+	http.Redirect(w, r, provider.AuthURL(state), http.StatusFound)
+}
+
+func handleAuthCallback(provider auth.Provider, providerName string, w http.ResponseWriter, r *http.Request) {
+	stateCookie, err := r.Cookie(oauthStateCookie)
+	if err != nil || stateCookie.Value == "" || stateCookie.Value != r.URL.Query().Get("state") {
+		http.Error(w, "invalid OAuth state", http.StatusBadRequest)
+		return
+	}
+
+	code := r.URL.Query().Get("code")
+	if code == "" {
+		http.Error(w, "Query parameter 'code' is required", http.StatusBadRequest)
+		return
+	}
+
+	token, err := provider.Exchange(r.Context(), code)
+	if err != nil {
+		http.Error(w, "Error exchanging code: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	userInfo, err := provider.Userinfo(r.Context(), token)
+	if err != nil {
+		http.Error(w, "Error fetching user info: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sess := &auth.Session{
+		Provider:    providerName,
+		UserID:      userInfo.ID,
+		Username:    userInfo.Username,
+		AccessToken: token.AccessToken,
+	}
+	if err := auth.SetSessionCookie(w, sess); err != nil {
+		http.Error(w, "Error creating session: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	fmt.Fprintf(w, "logged in as %s via %s", userInfo.Username, providerName)
+}
+
+// meResponse is what /me serializes, deliberately omitting
+// auth.Session.AccessToken so the upstream OAuth token never round-trips
+// into a readable response body.
+type meResponse struct {
+	Provider string `json:"provider"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// handleMe returns the caller's session, demonstrating a user-scoped route
+// gated by auth.CheckSession.
+func handleMe(w http.ResponseWriter, r *http.Request) {
+	sess, ok := auth.SessionFromContext(r.Context())
+	if !ok {
+		http.Error(w, "no active session", http.StatusUnauthorized)
+		return
+	}
+
+	writeJSON(w, meResponse{Provider: sess.Provider, UserID: sess.UserID, Username: sess.Username})
+}
+
+// handleAuthLogout clears the caller's session cookie in the browser. A copy
+// of the cookie held elsewhere still validates until its ExpiresAt unless
+// AUTH_SESSION_SECRET is rotated.
+func handleAuthLogout(w http.ResponseWriter, r *http.Request) {
+	auth.ClearSessionCookie(w)
+	fmt.Fprint(w, "logged out")
+}
+
+// generateState returns a random, URL-safe CSRF state token.
+func generateState() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/larson-carter/IGDB-Wrapper/igdb"
+	"github.com/larson-carter/IGDB-Wrapper/store"
+)
+
+// collectorInterval is how often the background collector polls IGDB for
+// records updated since the store's latest known updated_at.
+const collectorInterval = 15 * time.Minute
+
+// runCollector periodically syncs newly-updated IGDB games into db, keeping
+// the local cache warm so handleGameSearch can serve most requests without
+// hitting the IGDB API. It runs until ctx is canceled.
+func runCollector(ctx context.Context, client *igdb.Client, db *store.Store) {
+	collectOnce(ctx, client, db)
+
+	ticker := time.NewTicker(collectorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			collectOnce(ctx, client, db)
+		}
+	}
+}
+
+func collectOnce(ctx context.Context, client *igdb.Client, db *store.Store) {
+	since, err := db.MaxUpdatedAt(ctx)
+	if err != nil {
+		log.Printf("collector: reading latest updated_at: %v", err)
+		return
+	}
+
+	games, err := client.Games(ctx, igdb.Query().
+		Fields("name", "summary", "first_release_date", "cover", "updated_at", "platforms", "involved_companies").
+		Where(fmt.Sprintf("updated_at > %d", since)).
+		Sort("updated_at", "asc").
+		Limit(500))
+	if err != nil {
+		log.Printf("collector: fetching updated games: %v", err)
+		return
+	}
+
+	for _, g := range games {
+		err := db.UpsertGame(ctx, store.Game{
+			ID:          g.ID,
+			Name:        g.Name,
+			Summary:     g.Summary,
+			ReleaseDate: g.ReleaseDate,
+			Cover:       g.Cover,
+			UpdatedAt:   g.UpdatedAt,
+		})
+		if err != nil {
+			log.Printf("collector: upserting game %d: %v", g.ID, err)
+		}
+	}
+
+	log.Printf("collector: synced %d games updated since %d", len(games), since)
+
+	collectPlatforms(ctx, client, db, games)
+	collectCompanies(ctx, client, db, games)
+	collectReleaseDates(ctx, client, db, games)
+}
+
+// collectPlatforms fetches and caches every platform referenced by games.
+func collectPlatforms(ctx context.Context, client *igdb.Client, db *store.Store, games []igdb.Game) {
+	ids := uniqueGameRefIDs(games, func(g igdb.Game) []int64 { return g.Platforms })
+	if len(ids) == 0 {
+		return
+	}
+
+	platforms, err := client.Platforms(ctx, igdb.Query().
+		Fields("name", "abbreviation").
+		Where(whereIDIn(ids)).
+		Limit(500))
+	if err != nil {
+		log.Printf("collector: fetching platforms: %v", err)
+		return
+	}
+
+	for _, p := range platforms {
+		err := db.UpsertPlatform(ctx, store.Platform{ID: p.ID, Name: p.Name, Abbreviation: p.Abbreviation})
+		if err != nil {
+			log.Printf("collector: upserting platform %d: %v", p.ID, err)
+		}
+	}
+}
+
+// collectCompanies fetches and caches every company involved in games.
+func collectCompanies(ctx context.Context, client *igdb.Client, db *store.Store, games []igdb.Game) {
+	ids := uniqueGameRefIDs(games, func(g igdb.Game) []int64 { return g.InvolvedCompanies })
+	if len(ids) == 0 {
+		return
+	}
+
+	involved, err := client.InvolvedCompanies(ctx, igdb.Query().
+		Fields("company").
+		Where(whereIDIn(ids)).
+		Limit(500))
+	if err != nil {
+		log.Printf("collector: fetching involved companies: %v", err)
+		return
+	}
+
+	companyIDs := make([]int64, 0, len(involved))
+	for _, ic := range involved {
+		companyIDs = append(companyIDs, ic.CompanyID)
+	}
+	if len(companyIDs) == 0 {
+		return
+	}
+
+	companies, err := client.Companies(ctx, igdb.Query().
+		Fields("name", "country").
+		Where(whereIDIn(companyIDs)).
+		Limit(500))
+	if err != nil {
+		log.Printf("collector: fetching companies: %v", err)
+		return
+	}
+
+	for _, c := range companies {
+		err := db.UpsertCompany(ctx, store.Company{ID: c.ID, Name: c.Name, Country: c.Country})
+		if err != nil {
+			log.Printf("collector: upserting company %d: %v", c.ID, err)
+		}
+	}
+}
+
+// collectReleaseDates fetches and caches every per-platform release date for games.
+func collectReleaseDates(ctx context.Context, client *igdb.Client, db *store.Store, games []igdb.Game) {
+	if len(games) == 0 {
+		return
+	}
+	gameIDs := make([]int64, 0, len(games))
+	for _, g := range games {
+		gameIDs = append(gameIDs, g.ID)
+	}
+
+	releaseDates, err := client.ReleaseDates(ctx, igdb.Query().
+		Fields("game", "platform", "date", "region").
+		Where(fmt.Sprintf("game = (%s)", joinInt64s(gameIDs))).
+		Limit(500))
+	if err != nil {
+		log.Printf("collector: fetching release dates: %v", err)
+		return
+	}
+
+	for _, rd := range releaseDates {
+		err := db.UpsertReleaseDate(ctx, store.ReleaseDate{
+			ID:         rd.ID,
+			GameID:     rd.GameID,
+			PlatformID: rd.PlatformID,
+			Date:       rd.Date,
+			Region:     rd.Region,
+		})
+		if err != nil {
+			log.Printf("collector: upserting release date %d: %v", rd.ID, err)
+		}
+	}
+}
+
+// uniqueGameRefIDs collects the deduplicated IDs refs extracts from each game.
+func uniqueGameRefIDs(games []igdb.Game, refs func(igdb.Game) []int64) []int64 {
+	seen := make(map[int64]bool)
+	var ids []int64
+	for _, g := range games {
+		for _, id := range refs(g) {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// whereIDIn renders an Apicalypse "id = (...)" filter over ids, for use with
+// QueryBuilder.Where.
+func whereIDIn(ids []int64) string {
+	return fmt.Sprintf("id = (%s)", joinInt64s(ids))
+}
+
+// joinInt64s renders ids as a comma-separated list for an Apicalypse clause.
+func joinInt64s(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/larson-carter/IGDB-Wrapper/igdb"
+	"github.com/larson-carter/IGDB-Wrapper/store"
+)
+
+// gameSearchResponse is the /games/search response envelope.
+type gameSearchResponse struct {
+	Data       []igdb.Game `json:"data"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	Total      int64       `json:"total"`
+}
+
+// handleGameSearch handles requests to search for games. Plain, unfiltered,
+// first-page queries are served from the local store; everything else
+// (filters, sorting, pagination beyond page one) queries IGDB directly, with
+// results cached for future lookups by ID.
+func handleGameSearch(client *igdb.Client, db *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := parseGameSearchParams(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if params.isSimpleCacheable() {
+			// Fetch one row past the limit so a full match set (no more rows
+			// than requested) can be told apart from a truncated one, since
+			// the cache has no way to run the IGDB /games/count query this
+			// path would otherwise need for an accurate Total/NextCursor.
+			cached, err := db.SearchGamesByName(r.Context(), params.Query, params.Limit)
+			if err != nil {
+				log.Printf("games/search: cache lookup failed: %v", err)
+			} else if len(cached) > 0 && len(cached) <= params.Limit {
+				writeJSON(w, gameSearchResponse{Data: storeGamesToIGDBGames(cached), Total: int64(len(cached))})
+				return
+			}
+		}
+
+		var (
+			games    []igdb.Game
+			gamesErr error
+			total    int64
+			totalErr error
+			wg       sync.WaitGroup
+		)
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			games, gamesErr = client.Games(r.Context(), params.buildQuery())
+		}()
+		go func() {
+			defer wg.Done()
+			total, totalErr = client.GamesCount(r.Context(), params.countQuery())
+		}()
+		wg.Wait()
+
+		if gamesErr != nil {
+			http.Error(w, "Error fetching games: "+gamesErr.Error(), http.StatusInternalServerError)
+			return
+		}
+		if totalErr != nil {
+			log.Printf("games/search: count query failed: %v", totalErr)
+		}
+
+		for _, g := range games {
+			cacheGame(r, db, g)
+		}
+
+		resp := gameSearchResponse{Data: games, Total: total}
+		if int64(params.Offset+len(games)) < total {
+			resp.NextCursor = encodeCursor(params.Offset + params.Limit)
+		}
+
+		writeJSON(w, resp)
+	}
+}
+
+// storeGamesToIGDBGames adapts cached store.Game rows to the igdb.Game shape
+// the /games/search response envelope is built around.
+func storeGamesToIGDBGames(cached []store.Game) []igdb.Game {
+	games := make([]igdb.Game, len(cached))
+	for i, g := range cached {
+		games[i] = igdb.Game{
+			ID:          g.ID,
+			Name:        g.Name,
+			Summary:     g.Summary,
+			ReleaseDate: g.ReleaseDate,
+			Cover:       g.Cover,
+			UpdatedAt:   g.UpdatedAt,
+		}
+	}
+	return games
+}
+
+func cacheGame(r *http.Request, db *store.Store, g igdb.Game) {
+	err := db.UpsertGame(r.Context(), store.Game{
+		ID:          g.ID,
+		Name:        g.Name,
+		Summary:     g.Summary,
+		ReleaseDate: g.ReleaseDate,
+		Cover:       g.Cover,
+		UpdatedAt:   g.UpdatedAt,
+	})
+	if err != nil {
+		log.Printf("games/search: caching game %d failed: %v", g.ID, err)
+	}
+}
+
+// handleGamesRoute dispatches /games/{id}, /games/upcoming, and /games/recent
+// requests, all served entirely from the local store.
+func handleGamesRoute(db *store.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/games/")
+
+		switch path {
+		case "upcoming":
+			handleUpcomingGames(db, w, r)
+			return
+		case "recent":
+			handleRecentGames(db, w, r)
+			return
+		}
+
+		id, err := strconv.ParseInt(path, 10, 64)
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		handleGameByID(db, id, w, r)
+	}
+}
+
+func handleGameByID(db *store.Store, id int64, w http.ResponseWriter, r *http.Request) {
+	game, err := db.Game(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Error fetching game: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if game == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, game)
+}
+
+func handleUpcomingGames(db *store.Store, w http.ResponseWriter, r *http.Request) {
+	games, err := db.UpcomingGames(r.Context(), time.Now().Unix(), 50)
+	if err != nil {
+		http.Error(w, "Error fetching upcoming games: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, games)
+}
+
+func handleRecentGames(db *store.Store, w http.ResponseWriter, r *http.Request) {
+	games, err := db.RecentGames(r.Context(), time.Now().Unix(), 50)
+	if err != nil {
+		http.Error(w, "Error fetching recent games: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, games)
+}
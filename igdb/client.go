@@ -0,0 +1,237 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// baseURL is the IGDB v4 API root.
+const baseURL = "https://api.igdb.com/v4"
+
+// Client is an IGDB v4 API client. It authenticates via Token, a cached
+// Twitch client-credentials TokenSource, so callers never re-authenticate
+// per request.
+type Client struct {
+	ClientID string
+	Token    *TokenSource
+
+	httpClient *resty.Client
+}
+
+// apiError is a single element of the JSON array IGDB returns in place of a
+// normal response body when a request fails.
+type apiError struct {
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Cause  string `json:"cause"`
+}
+
+// checkResponse returns an error describing resp if it represents an IGDB
+// failure, so callers never unmarshal an error payload as if it were a
+// successful result.
+func checkResponse(resp *resty.Response, endpoint string) error {
+	if !resp.IsError() {
+		return nil
+	}
+
+	var apiErrs []apiError
+	if err := json.Unmarshal(resp.Body(), &apiErrs); err == nil && len(apiErrs) > 0 {
+		return fmt.Errorf("igdb: %s: %d %s: %s", endpoint, apiErrs[0].Status, apiErrs[0].Title, apiErrs[0].Cause)
+	}
+
+	return fmt.Errorf("igdb: %s: request failed with status %d", endpoint, resp.StatusCode())
+}
+
+// NewClient builds an IGDB Client for the given Twitch app credentials.
+func NewClient(clientID, clientSecret string) (*Client, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, fmt.Errorf("igdb: client ID or client secret is not set")
+	}
+
+	return &Client{
+		ClientID:   clientID,
+		Token:      NewTokenSource(clientID, clientSecret, ""),
+		httpClient: resty.New(),
+	}, nil
+}
+
+// do executes a built Apicalypse query against endpoint and decodes the
+// response into out, which must be a pointer to a slice of the endpoint's
+// result type.
+func (c *Client) do(ctx context.Context, endpoint string, q *QueryBuilder, out interface{}) error {
+	body, err := q.Build()
+	if err != nil {
+		return err
+	}
+
+	accessToken, err := c.Token.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("igdb: fetching access token: %w", err)
+	}
+
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Client-ID", c.ClientID).
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", accessToken)).
+		SetBody(body).
+		Post(fmt.Sprintf("%s/%s", baseURL, endpoint))
+	if err != nil {
+		return fmt.Errorf("igdb: requesting %s: %w", endpoint, err)
+	}
+	if err := checkResponse(resp, endpoint); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), out); err != nil {
+		return fmt.Errorf("igdb: decoding %s response: %w", endpoint, err)
+	}
+
+	return nil
+}
+
+// Games queries the IGDB /games endpoint.
+func (c *Client) Games(ctx context.Context, q *QueryBuilder) ([]Game, error) {
+	var games []Game
+	if err := c.do(ctx, "games", q, &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
+
+// GamesCount queries the IGDB /games/count endpoint, which honors q's
+// Search and Where clauses and reports how many games match them in total.
+func (c *Client) GamesCount(ctx context.Context, q *QueryBuilder) (int64, error) {
+	body, err := q.Build()
+	if err != nil {
+		return 0, err
+	}
+
+	accessToken, err := c.Token.Token(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("igdb: fetching access token: %w", err)
+	}
+
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Client-ID", c.ClientID).
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", accessToken)).
+		SetBody(body).
+		Post(fmt.Sprintf("%s/games/count", baseURL))
+	if err != nil {
+		return 0, fmt.Errorf("igdb: requesting games/count: %w", err)
+	}
+	if err := checkResponse(resp, "games/count"); err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Count int64 `json:"count"`
+	}
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return 0, fmt.Errorf("igdb: decoding games/count response: %w", err)
+	}
+
+	return result.Count, nil
+}
+
+// Companies queries the IGDB /companies endpoint.
+func (c *Client) Companies(ctx context.Context, q *QueryBuilder) ([]Company, error) {
+	var companies []Company
+	if err := c.do(ctx, "companies", q, &companies); err != nil {
+		return nil, err
+	}
+	return companies, nil
+}
+
+// Platforms queries the IGDB /platforms endpoint.
+func (c *Client) Platforms(ctx context.Context, q *QueryBuilder) ([]Platform, error) {
+	var platforms []Platform
+	if err := c.do(ctx, "platforms", q, &platforms); err != nil {
+		return nil, err
+	}
+	return platforms, nil
+}
+
+// Genres queries the IGDB /genres endpoint.
+func (c *Client) Genres(ctx context.Context, q *QueryBuilder) ([]Genre, error) {
+	var genres []Genre
+	if err := c.do(ctx, "genres", q, &genres); err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+// Covers queries the IGDB /covers endpoint.
+func (c *Client) Covers(ctx context.Context, q *QueryBuilder) ([]Cover, error) {
+	var covers []Cover
+	if err := c.do(ctx, "covers", q, &covers); err != nil {
+		return nil, err
+	}
+	return covers, nil
+}
+
+// Screenshots queries the IGDB /screenshots endpoint.
+func (c *Client) Screenshots(ctx context.Context, q *QueryBuilder) ([]Screenshot, error) {
+	var screenshots []Screenshot
+	if err := c.do(ctx, "screenshots", q, &screenshots); err != nil {
+		return nil, err
+	}
+	return screenshots, nil
+}
+
+// InvolvedCompanies queries the IGDB /involved_companies endpoint.
+func (c *Client) InvolvedCompanies(ctx context.Context, q *QueryBuilder) ([]InvolvedCompany, error) {
+	var involvedCompanies []InvolvedCompany
+	if err := c.do(ctx, "involved_companies", q, &involvedCompanies); err != nil {
+		return nil, err
+	}
+	return involvedCompanies, nil
+}
+
+// ReleaseDates queries the IGDB /release_dates endpoint.
+func (c *Client) ReleaseDates(ctx context.Context, q *QueryBuilder) ([]ReleaseDate, error) {
+	var releaseDates []ReleaseDate
+	if err := c.do(ctx, "release_dates", q, &releaseDates); err != nil {
+		return nil, err
+	}
+	return releaseDates, nil
+}
+
+// Franchises queries the IGDB /franchises endpoint.
+func (c *Client) Franchises(ctx context.Context, q *QueryBuilder) ([]Franchise, error) {
+	var franchises []Franchise
+	if err := c.do(ctx, "franchises", q, &franchises); err != nil {
+		return nil, err
+	}
+	return franchises, nil
+}
+
+// AgeRatings queries the IGDB /age_ratings endpoint.
+func (c *Client) AgeRatings(ctx context.Context, q *QueryBuilder) ([]AgeRating, error) {
+	var ageRatings []AgeRating
+	if err := c.do(ctx, "age_ratings", q, &ageRatings); err != nil {
+		return nil, err
+	}
+	return ageRatings, nil
+}
+
+// Themes queries the IGDB /themes endpoint.
+func (c *Client) Themes(ctx context.Context, q *QueryBuilder) ([]Theme, error) {
+	var themes []Theme
+	if err := c.do(ctx, "themes", q, &themes); err != nil {
+		return nil, err
+	}
+	return themes, nil
+}
+
+// GameModes queries the IGDB /game_modes endpoint.
+func (c *Client) GameModes(ctx context.Context, q *QueryBuilder) ([]GameMode, error) {
+	var gameModes []GameMode
+	if err := c.do(ctx, "game_modes", q, &gameModes); err != nil {
+		return nil, err
+	}
+	return gameModes, nil
+}
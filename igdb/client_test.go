@@ -0,0 +1,44 @@
+package igdb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestCheckResponseReportsIGDBAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		body, _ := json.Marshal([]apiError{{Title: "Authorization Failure", Status: 401, Cause: "Invalid token"}})
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	resp, err := resty.New().R().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+
+	if err := checkResponse(resp, "games"); err == nil {
+		t.Fatal("expected error for a 401 response, got nil")
+	}
+}
+
+func TestCheckResponseAllowsSuccess(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	resp, err := resty.New().R().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+
+	if err := checkResponse(resp, "games"); err != nil {
+		t.Errorf("checkResponse() = %v, want nil for a 200 response", err)
+	}
+}
@@ -0,0 +1,117 @@
+package igdb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryBuilder assembles an Apicalypse query string (the query language IGDB
+// expects as the POST body for every endpoint) via a fluent, chainable API.
+type QueryBuilder struct {
+	search    string
+	hasSearch bool
+
+	fields []string
+
+	where string
+
+	sortField string
+	sortDir   string
+	hasSort   bool
+
+	limit  int
+	offset int
+}
+
+// Query starts a new QueryBuilder.
+func Query() *QueryBuilder {
+	return &QueryBuilder{}
+}
+
+// Search adds a full-text search clause. IGDB rejects combining Search with
+// Sort, which Build enforces.
+func (q *QueryBuilder) Search(term string) *QueryBuilder {
+	q.search = term
+	q.hasSearch = true
+	return q
+}
+
+// Fields selects which fields to return, including expander syntax such as
+// "cover.*" or "involved_companies.company.name".
+func (q *QueryBuilder) Fields(fields ...string) *QueryBuilder {
+	q.fields = fields
+	return q
+}
+
+// Where adds a raw Apicalypse filter clause, e.g. "platforms = (48,49)".
+func (q *QueryBuilder) Where(clause string) *QueryBuilder {
+	q.where = clause
+	return q
+}
+
+// Sort orders results by field in the given direction ("asc" or "desc").
+// IGDB rejects combining Sort with Search, which Build enforces.
+func (q *QueryBuilder) Sort(field, direction string) *QueryBuilder {
+	q.sortField = field
+	q.sortDir = direction
+	q.hasSort = true
+	return q
+}
+
+// Limit caps the number of results returned (IGDB's own ceiling is 500).
+func (q *QueryBuilder) Limit(n int) *QueryBuilder {
+	q.limit = n
+	return q
+}
+
+// Offset skips the first n results, for page-by-page iteration.
+func (q *QueryBuilder) Offset(n int) *QueryBuilder {
+	q.offset = n
+	return q
+}
+
+// Build renders the Apicalypse query string, escaping quotes in Search and
+// rejecting the Search+Sort combination IGDB itself rejects.
+func (q *QueryBuilder) Build() (string, error) {
+	if q.hasSearch && q.hasSort {
+		return "", fmt.Errorf("igdb: Search and Sort cannot be combined in the same query")
+	}
+
+	var b strings.Builder
+
+	if q.hasSearch {
+		fmt.Fprintf(&b, "search \"%s\"; ", escapeQuotes(q.search))
+	}
+
+	fields := q.fields
+	if len(fields) == 0 {
+		fields = []string{"*"}
+	}
+	fmt.Fprintf(&b, "fields %s;", strings.Join(fields, ","))
+
+	if q.where != "" {
+		fmt.Fprintf(&b, " where %s;", q.where)
+	}
+
+	if q.hasSort {
+		fmt.Fprintf(&b, " sort %s %s;", q.sortField, q.sortDir)
+	}
+
+	if q.limit > 0 {
+		fmt.Fprintf(&b, " limit %d;", q.limit)
+	}
+
+	if q.offset > 0 {
+		fmt.Fprintf(&b, " offset %d;", q.offset)
+	}
+
+	return b.String(), nil
+}
+
+// escapeQuotes escapes backslashes and double quotes so a search term can't
+// break out of the Apicalypse "search \"...\"" clause.
+func escapeQuotes(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return s
+}
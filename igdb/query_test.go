@@ -0,0 +1,28 @@
+package igdb
+
+import "testing"
+
+func TestQueryBuilderBuild(t *testing.T) {
+	got, err := Query().
+		Search(`halo "legendary"`).
+		Fields("name", "summary", "cover.*").
+		Where("platforms = (48,49)").
+		Limit(50).
+		Offset(100).
+		Build()
+	if err != nil {
+		t.Fatalf("Build returned error: %v", err)
+	}
+
+	want := `search "halo \"legendary\""; fields name,summary,cover.*; where platforms = (48,49); limit 50; offset 100;`
+	if got != want {
+		t.Errorf("Build() = %q, want %q", got, want)
+	}
+}
+
+func TestQueryBuilderSearchAndSortRejected(t *testing.T) {
+	_, err := Query().Search("halo").Sort("first_release_date", "desc").Build()
+	if err == nil {
+		t.Fatal("expected error combining Search and Sort, got nil")
+	}
+}
@@ -0,0 +1,105 @@
+package igdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// tokenRefreshSkew is how far ahead of expiry we proactively refresh the
+// cached token, so in-flight requests never race a just-expired token.
+const tokenRefreshSkew = 60 * time.Second
+
+// OAuthToken represents the response structure for the Twitch OAuth token
+type OAuthToken struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// TokenSource caches a Twitch client-credentials OAuth token in memory and
+// transparently refreshes it once it comes within tokenRefreshSkew of
+// expiring, modeled after golang.org/x/oauth2/clientcredentials.
+type TokenSource struct {
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+
+	httpClient *resty.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+// NewTokenSource builds a TokenSource for the given Twitch app credentials.
+// If tokenURL is empty it defaults to the Twitch OAuth2 token endpoint.
+func NewTokenSource(clientID, clientSecret, tokenURL string) *TokenSource {
+	if tokenURL == "" {
+		tokenURL = "https://id.twitch.tv/oauth2/token"
+	}
+
+	return &TokenSource{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+		httpClient:   resty.New(),
+	}
+}
+
+// Token returns a valid access token, refreshing it first if it is missing
+// or within tokenRefreshSkew of expiring.
+func (ts *TokenSource) Token(ctx context.Context) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.accessToken != "" && time.Until(ts.expiry) > tokenRefreshSkew {
+		return ts.accessToken, nil
+	}
+
+	if err := ts.refresh(ctx); err != nil {
+		return "", err
+	}
+
+	return ts.accessToken, nil
+}
+
+// refresh fetches a fresh token from TokenURL and caches it. Callers must
+// hold ts.mu.
+func (ts *TokenSource) refresh(ctx context.Context) error {
+	if ts.ClientID == "" || ts.ClientSecret == "" {
+		return fmt.Errorf("TokenSource: client ID or client secret is not set")
+	}
+
+	params := url.Values{}
+	params.Add("client_id", ts.ClientID)
+	params.Add("client_secret", ts.ClientSecret)
+	params.Add("grant_type", "client_credentials")
+
+	resp, err := ts.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/x-www-form-urlencoded").
+		SetBody(params.Encode()).
+		Post(ts.TokenURL)
+	if err != nil {
+		return fmt.Errorf("TokenSource: requesting token: %w", err)
+	}
+
+	var token OAuthToken
+	if err := json.Unmarshal(resp.Body(), &token); err != nil {
+		return fmt.Errorf("TokenSource: decoding token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return fmt.Errorf("TokenSource: token response missing access_token")
+	}
+
+	ts.accessToken = token.AccessToken
+	ts.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package igdb
+
+// Game represents the structure of the IGDB game data
+type Game struct {
+	ID                int64   `json:"id"`
+	Name              string  `json:"name"`
+	Summary           string  `json:"summary"`
+	ReleaseDate       int64   `json:"first_release_date"`
+	Cover             int64   `json:"cover"`
+	Platforms         []int64 `json:"platforms"`
+	Genres            []int64 `json:"genres"`
+	InvolvedCompanies []int64 `json:"involved_companies"`
+	Franchises        []int64 `json:"franchises"`
+	GameModes         []int64 `json:"game_modes"`
+	AgeRatings        []int64 `json:"age_ratings"`
+	Themes            []int64 `json:"themes"`
+	Screenshots       []int64 `json:"screenshots"`
+	UpdatedAt         int64   `json:"updated_at"`
+}
+
+// Company represents an IGDB game company (developer or publisher)
+type Company struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Country     int    `json:"country"`
+	Website     string `json:"website"`
+}
+
+// Platform represents an IGDB hardware/software platform
+type Platform struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+	Category     int    `json:"category"`
+}
+
+// Genre represents an IGDB game genre
+type Genre struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// Cover represents an IGDB box art / cover image
+type Cover struct {
+	ID      int64  `json:"id"`
+	GameID  int64  `json:"game"`
+	ImageID string `json:"image_id"`
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+}
+
+// Screenshot represents an IGDB in-game screenshot
+type Screenshot struct {
+	ID      int64  `json:"id"`
+	GameID  int64  `json:"game"`
+	ImageID string `json:"image_id"`
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+}
+
+// InvolvedCompany links a Company to a Game with its role in development
+type InvolvedCompany struct {
+	ID         int64 `json:"id"`
+	CompanyID  int64 `json:"company"`
+	GameID     int64 `json:"game"`
+	Developer  bool  `json:"developer"`
+	Publisher  bool  `json:"publisher"`
+	Porting    bool  `json:"porting"`
+	Supporting bool  `json:"supporting"`
+}
+
+// ReleaseDate represents a per-platform, per-region release date for a Game
+type ReleaseDate struct {
+	ID         int64  `json:"id"`
+	GameID     int64  `json:"game"`
+	PlatformID int64  `json:"platform"`
+	Date       int64  `json:"date"`
+	Human      string `json:"human"`
+	Region     int    `json:"region"`
+}
+
+// Franchise represents an IGDB game franchise/series
+type Franchise struct {
+	ID    int64   `json:"id"`
+	Name  string  `json:"name"`
+	Slug  string  `json:"slug"`
+	Games []int64 `json:"games"`
+}
+
+// AgeRating represents an IGDB content rating (e.g. ESRB, PEGI)
+type AgeRating struct {
+	ID       int64  `json:"id"`
+	Category int    `json:"category"`
+	Rating   int    `json:"rating"`
+	Synopsis string `json:"synopsis"`
+}
+
+// Theme represents an IGDB game theme
+type Theme struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// GameMode represents an IGDB game mode (e.g. single player, co-operative)
+type GameMode struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
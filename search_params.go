@@ -0,0 +1,305 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/larson-carter/IGDB-Wrapper/igdb"
+)
+
+// defaultGameSearchFields is used when the caller doesn't specify ?fields=.
+var defaultGameSearchFields = []string{"name", "first_release_date", "summary", "cover", "updated_at"}
+
+// gameSearchFieldAllowlist is the set of top-level igdb.Game fields (and
+// expandable relations) that ?fields= and ?sort= are allowed to reference,
+// matching the shape of igdb.Game. It exists for the same reason parseIDList
+// does: without it, a caller can smuggle arbitrary "where"/"sort" clauses
+// past whatever filters the handler intended via the "fields %s;"/"sort %s
+// %s;" Apicalypse clauses.
+var gameSearchFieldAllowlist = map[string]bool{
+	"*":                  true,
+	"id":                 true,
+	"name":               true,
+	"summary":            true,
+	"first_release_date": true,
+	"cover":              true,
+	"platforms":          true,
+	"genres":             true,
+	"involved_companies": true,
+	"franchises":         true,
+	"game_modes":         true,
+	"age_ratings":        true,
+	"themes":             true,
+	"screenshots":        true,
+	"updated_at":         true,
+}
+
+// fieldPathSegment matches one "."-separated segment of a field expander
+// path, e.g. the "company" in "involved_companies.company.name".
+var fieldPathSegment = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// validSortDirections is the set of directions ?sort= may ask for.
+var validSortDirections = map[string]bool{"asc": true, "desc": true}
+
+// defaultGameSearchLimit is used when the caller doesn't specify ?limit=.
+const defaultGameSearchLimit = 50
+
+// maxGameSearchLimit caps ?limit= regardless of what the caller asks for.
+const maxGameSearchLimit = 500
+
+// gameSearchParams is the parsed, validated form of /games/search's query
+// parameters, ready to be translated into Apicalypse clauses.
+type gameSearchParams struct {
+	Query     string
+	Fields    []string
+	HasFields bool
+
+	Platforms []string
+	Genres    []string
+
+	YearMin, YearMax       int
+	HasYearMin, HasYearMax bool
+
+	Sort, SortDir string
+	HasSort       bool
+
+	Limit, Offset int
+}
+
+// parseGameSearchParams parses and validates /games/search's query
+// parameters, returning a 400-worthy error if they're contradictory or
+// malformed.
+func parseGameSearchParams(r *http.Request) (*gameSearchParams, error) {
+	q := r.URL.Query()
+
+	p := &gameSearchParams{
+		Query:  q.Get("q"),
+		Fields: defaultGameSearchFields,
+		Limit:  defaultGameSearchLimit,
+	}
+
+	if fields := q.Get("fields"); fields != "" {
+		parsed, err := parseFieldList(fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fields: %w", err)
+		}
+		p.Fields = parsed
+		p.HasFields = true
+	}
+	if platforms := q.Get("platforms"); platforms != "" {
+		ids, err := parseIDList(platforms)
+		if err != nil {
+			return nil, fmt.Errorf("invalid platforms: %w", err)
+		}
+		p.Platforms = ids
+	}
+	if genres := q.Get("genres"); genres != "" {
+		ids, err := parseIDList(genres)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genres: %w", err)
+		}
+		p.Genres = ids
+	}
+
+	if v := q.Get("year_min"); v != "" {
+		year, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid year_min: %q", v)
+		}
+		p.YearMin, p.HasYearMin = year, true
+	}
+	if v := q.Get("year_max"); v != "" {
+		year, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid year_max: %q", v)
+		}
+		p.YearMax, p.HasYearMax = year, true
+	}
+
+	if v := q.Get("sort"); v != "" {
+		field, dir, ok := strings.Cut(v, ":")
+		if !ok {
+			dir = "asc"
+		}
+		if err := validateFieldPath(field); err != nil {
+			return nil, fmt.Errorf("invalid sort field: %w", err)
+		}
+		if !validSortDirections[dir] {
+			return nil, fmt.Errorf("invalid sort direction: %q", dir)
+		}
+		p.Sort, p.SortDir, p.HasSort = field, dir, true
+	}
+	if p.Query != "" && p.HasSort {
+		return nil, fmt.Errorf("'sort' cannot be combined with 'q'")
+	}
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid limit: %q", v)
+		}
+		if limit > maxGameSearchLimit {
+			limit = maxGameSearchLimit
+		}
+		p.Limit = limit
+	}
+
+	if cursor := q.Get("cursor"); cursor != "" {
+		offset, err := decodeCursor(cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %q", cursor)
+		}
+		p.Offset = offset
+	} else if v := q.Get("offset"); v != "" {
+		offset, err := strconv.Atoi(v)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid offset: %q", v)
+		}
+		p.Offset = offset
+	}
+
+	return p, nil
+}
+
+// where renders the platforms/genres/year_min/year_max filters as an
+// Apicalypse "where" clause, or "" if none were given.
+func (p *gameSearchParams) where() string {
+	var clauses []string
+
+	if len(p.Platforms) > 0 {
+		clauses = append(clauses, fmt.Sprintf("platforms = (%s)", strings.Join(p.Platforms, ",")))
+	}
+	if len(p.Genres) > 0 {
+		clauses = append(clauses, fmt.Sprintf("genres = (%s)", strings.Join(p.Genres, ",")))
+	}
+	if p.HasYearMin {
+		clauses = append(clauses, fmt.Sprintf("first_release_date >= %d", yearStart(p.YearMin)))
+	}
+	if p.HasYearMax {
+		clauses = append(clauses, fmt.Sprintf("first_release_date <= %d", yearEnd(p.YearMax)))
+	}
+
+	return strings.Join(clauses, " & ")
+}
+
+// buildQuery assembles the full Apicalypse query used to fetch a page of results.
+func (p *gameSearchParams) buildQuery() *igdb.QueryBuilder {
+	qb := igdb.Query().Fields(p.Fields...).Limit(p.Limit).Offset(p.Offset)
+	if p.Query != "" {
+		qb = qb.Search(p.Query)
+	}
+	if where := p.where(); where != "" {
+		qb = qb.Where(where)
+	}
+	if p.HasSort {
+		qb = qb.Sort(p.Sort, p.SortDir)
+	}
+	return qb
+}
+
+// countQuery assembles the Apicalypse query used against /games/count,
+// which only cares about Search and Where.
+func (p *gameSearchParams) countQuery() *igdb.QueryBuilder {
+	qb := igdb.Query()
+	if p.Query != "" {
+		qb = qb.Search(p.Query)
+	}
+	if where := p.where(); where != "" {
+		qb = qb.Where(where)
+	}
+	return qb
+}
+
+// isSimpleCacheable reports whether this request is a plain, first-page
+// keyword search with no filters or sorting, the only shape the local store
+// cache can currently serve without hitting IGDB. The cache lookup itself
+// still falls through to IGDB if the match set turns out to be truncated at
+// Limit, since the cache can't produce an accurate Total/NextCursor for that.
+func (p *gameSearchParams) isSimpleCacheable() bool {
+	return p.Query != "" &&
+		p.Offset == 0 &&
+		!p.HasSort &&
+		!p.HasFields &&
+		!p.HasYearMin && !p.HasYearMax &&
+		len(p.Platforms) == 0 && len(p.Genres) == 0
+}
+
+func yearStart(year int) int64 {
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+func yearEnd(year int) int64 {
+	return time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second).Unix()
+}
+
+// encodeCursor turns a result offset into an opaque pagination cursor.
+func encodeCursor(offset int) string {
+	return base64.URLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodeCursor reverses encodeCursor.
+func decodeCursor(cursor string) (int, error) {
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(b))
+}
+
+// parseFieldList validates a comma-separated ?fields= value against
+// gameSearchFieldAllowlist, permitting the "a.b"/"a.*" expander syntax IGDB
+// itself supports but rejecting anything else, for the same reason
+// parseIDList rejects non-numeric platforms/genres.
+func parseFieldList(raw string) ([]string, error) {
+	parts := strings.Split(raw, ",")
+	fields := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if err := validateFieldPath(part); err != nil {
+			return nil, err
+		}
+		fields = append(fields, part)
+	}
+	return fields, nil
+}
+
+// validateFieldPath checks a single "."-separated field path (as used by
+// both ?fields= and ?sort=) against gameSearchFieldAllowlist, rejecting
+// anything that isn't a recognized field or a valid expander path into one.
+func validateFieldPath(path string) error {
+	segments := strings.Split(path, ".")
+	if !gameSearchFieldAllowlist[segments[0]] {
+		return fmt.Errorf("%q is not a recognized field", segments[0])
+	}
+	for _, seg := range segments[1:] {
+		if seg == "*" {
+			continue
+		}
+		if !fieldPathSegment.MatchString(seg) {
+			return fmt.Errorf("%q is not a valid field path segment", seg)
+		}
+	}
+	return nil
+}
+
+// parseIDList validates a comma-separated query param as a list of
+// non-negative IGDB IDs, rejecting anything else so it can't be used to
+// smuggle arbitrary clauses into the Apicalypse "where" string.
+func parseIDList(raw string) ([]string, error) {
+	parts := strings.Split(raw, ",")
+	ids := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%q is not a non-negative integer", part)
+		}
+		ids = append(ids, strconv.Itoa(n))
+	}
+	return ids, nil
+}
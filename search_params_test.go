@@ -0,0 +1,103 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseGameSearchParamsRejectsSortWithQuery(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?q=halo&sort=first_release_date:desc", nil)
+
+	if _, err := parseGameSearchParams(r); err == nil {
+		t.Fatal("expected error combining q and sort, got nil")
+	}
+}
+
+func TestParseGameSearchParamsCursorRoundTrip(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?q=halo&cursor="+encodeCursor(150), nil)
+
+	params, err := parseGameSearchParams(r)
+	if err != nil {
+		t.Fatalf("parseGameSearchParams: %v", err)
+	}
+	if params.Offset != 150 {
+		t.Errorf("Offset = %d, want 150", params.Offset)
+	}
+}
+
+func TestParseGameSearchParamsBuildsWhereClause(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?platforms=48,49&year_min=2020", nil)
+
+	params, err := parseGameSearchParams(r)
+	if err != nil {
+		t.Fatalf("parseGameSearchParams: %v", err)
+	}
+
+	want := "platforms = (48,49) & first_release_date >= 1577836800"
+	if got := params.where(); got != want {
+		t.Errorf("where() = %q, want %q", got, want)
+	}
+}
+
+func TestParseGameSearchParamsRejectsNonNumericPlatforms(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?platforms=48%29%3Bfields+no_such_clause", nil)
+
+	if _, err := parseGameSearchParams(r); err == nil {
+		t.Fatal("expected error for non-numeric platforms, got nil")
+	}
+}
+
+func TestParseGameSearchParamsRejectsInjectedFields(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?q=halo&fields=name%3Bwhere+id+%3D+1%3Bsort+id+asc", nil)
+
+	if _, err := parseGameSearchParams(r); err == nil {
+		t.Fatal("expected error for injected fields clause, got nil")
+	}
+}
+
+func TestParseGameSearchParamsRejectsInjectedSort(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?sort=id%3Bwhere+id+%3D+1%3Aasc", nil)
+
+	if _, err := parseGameSearchParams(r); err == nil {
+		t.Fatal("expected error for injected sort clause, got nil")
+	}
+}
+
+func TestParseGameSearchParamsRejectsUnknownSortDirection(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?sort=name:sideways", nil)
+
+	if _, err := parseGameSearchParams(r); err == nil {
+		t.Fatal("expected error for unknown sort direction, got nil")
+	}
+}
+
+func TestParseGameSearchParamsAllowsFieldExpanders(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?q=halo&fields=name,cover.image_id,involved_companies.company.name", nil)
+
+	params, err := parseGameSearchParams(r)
+	if err != nil {
+		t.Fatalf("parseGameSearchParams: %v", err)
+	}
+	want := []string{"name", "cover.image_id", "involved_companies.company.name"}
+	if len(params.Fields) != len(want) {
+		t.Fatalf("Fields = %v, want %v", params.Fields, want)
+	}
+	for i := range want {
+		if params.Fields[i] != want[i] {
+			t.Errorf("Fields[%d] = %q, want %q", i, params.Fields[i], want[i])
+		}
+	}
+}
+
+func TestParseGameSearchParamsIsSimpleCacheableRejectsExplicitFields(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/games/search?q=halo&fields=name,platforms", nil)
+
+	params, err := parseGameSearchParams(r)
+	if err != nil {
+		t.Fatalf("parseGameSearchParams: %v", err)
+	}
+	if params.isSimpleCacheable() {
+		t.Error("isSimpleCacheable() = true, want false when fields is explicit")
+	}
+}
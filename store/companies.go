@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// UpsertCompany inserts c or, if its ID already exists, updates it in place.
+func (s *Store) UpsertCompany(ctx context.Context, c Company) error {
+	var query string
+	if s.driver == "postgres" {
+		query = `INSERT INTO companies (id, name, country)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (id) DO UPDATE SET
+				name = EXCLUDED.name, country = EXCLUDED.country`
+	} else {
+		query = `INSERT INTO companies (id, name, country)
+			VALUES (?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET
+				name = excluded.name, country = excluded.country`
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, c.ID, c.Name, c.Country); err != nil {
+		return fmt.Errorf("store: upserting company %d: %w", c.ID, err)
+	}
+	return nil
+}
+
+// Company fetches a single cached company by ID, returning (nil, nil) on a
+// cache miss.
+func (s *Store) Company(ctx context.Context, id int64) (*Company, error) {
+	query := fmt.Sprintf(`SELECT id, name, country FROM companies WHERE id = %s`, s.ph(1))
+
+	var c Company
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&c.ID, &c.Name, &c.Country)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: fetching company %d: %w", id, err)
+	}
+	return &c, nil
+}
@@ -0,0 +1,124 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// UpsertGame inserts g or, if its ID already exists, updates it in place.
+func (s *Store) UpsertGame(ctx context.Context, g Game) error {
+	var query string
+	if s.driver == "postgres" {
+		query = `INSERT INTO games (id, name, summary, first_release_date, cover, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO UPDATE SET
+				name = EXCLUDED.name, summary = EXCLUDED.summary,
+				first_release_date = EXCLUDED.first_release_date,
+				cover = EXCLUDED.cover, updated_at = EXCLUDED.updated_at`
+	} else {
+		query = `INSERT INTO games (id, name, summary, first_release_date, cover, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET
+				name = excluded.name, summary = excluded.summary,
+				first_release_date = excluded.first_release_date,
+				cover = excluded.cover, updated_at = excluded.updated_at`
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, g.ID, g.Name, g.Summary, g.ReleaseDate, g.Cover, g.UpdatedAt); err != nil {
+		return fmt.Errorf("store: upserting game %d: %w", g.ID, err)
+	}
+	return nil
+}
+
+// Game fetches a single cached game by ID, returning (nil, nil) on a cache miss.
+func (s *Store) Game(ctx context.Context, id int64) (*Game, error) {
+	query := fmt.Sprintf(`SELECT id, name, summary, first_release_date, cover, updated_at
+		FROM games WHERE id = %s`, s.ph(1))
+
+	var g Game
+	err := s.db.QueryRowContext(ctx, query, id).
+		Scan(&g.ID, &g.Name, &g.Summary, &g.ReleaseDate, &g.Cover, &g.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: fetching game %d: %w", id, err)
+	}
+	return &g, nil
+}
+
+// SearchGamesByName does a substring match of query against cached game
+// names, used to serve /games/search from the cache before falling back to
+// the IGDB API. It fetches limit+1 rows so the caller can tell whether the
+// match set was truncated without a separate count query.
+func (s *Store) SearchGamesByName(ctx context.Context, query string, limit int) ([]Game, error) {
+	sqlQuery := fmt.Sprintf(`SELECT id, name, summary, first_release_date, cover, updated_at
+		FROM games WHERE name LIKE %s ORDER BY name ASC LIMIT %s`, s.ph(1), s.ph(2))
+
+	rows, err := s.db.QueryContext(ctx, sqlQuery, "%"+query+"%", limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("store: searching games for %q: %w", query, err)
+	}
+	defer rows.Close()
+
+	return scanGames(rows)
+}
+
+// UpcomingGames returns cached games releasing after the given unix
+// timestamp, soonest first.
+func (s *Store) UpcomingGames(ctx context.Context, after int64, limit int) ([]Game, error) {
+	query := fmt.Sprintf(`SELECT id, name, summary, first_release_date, cover, updated_at
+		FROM games WHERE first_release_date > %s
+		ORDER BY first_release_date ASC LIMIT %s`, s.ph(1), s.ph(2))
+
+	rows, err := s.db.QueryContext(ctx, query, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("store: fetching upcoming games: %w", err)
+	}
+	defer rows.Close()
+
+	return scanGames(rows)
+}
+
+// RecentGames returns cached games released on or before the given unix
+// timestamp, most recent first.
+func (s *Store) RecentGames(ctx context.Context, before int64, limit int) ([]Game, error) {
+	query := fmt.Sprintf(`SELECT id, name, summary, first_release_date, cover, updated_at
+		FROM games WHERE first_release_date <= %s AND first_release_date > 0
+		ORDER BY first_release_date DESC LIMIT %s`, s.ph(1), s.ph(2))
+
+	rows, err := s.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("store: fetching recent games: %w", err)
+	}
+	defer rows.Close()
+
+	return scanGames(rows)
+}
+
+// MaxUpdatedAt returns the latest updated_at among cached games, or 0 if the
+// cache is empty, so the collector knows where to resume polling IGDB from.
+func (s *Store) MaxUpdatedAt(ctx context.Context) (int64, error) {
+	var max int64
+	err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(updated_at), 0) FROM games`).Scan(&max)
+	if err != nil {
+		return 0, fmt.Errorf("store: fetching latest updated_at: %w", err)
+	}
+	return max, nil
+}
+
+func scanGames(rows *sql.Rows) ([]Game, error) {
+	var games []Game
+	for rows.Next() {
+		var g Game
+		if err := rows.Scan(&g.ID, &g.Name, &g.Summary, &g.ReleaseDate, &g.Cover, &g.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("store: scanning game row: %w", err)
+		}
+		games = append(games, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("store: iterating game rows: %w", err)
+	}
+	return games, nil
+}
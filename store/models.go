@@ -0,0 +1,34 @@
+package store
+
+// Game is the cached, flattened subset of igdb.Game fields the store persists.
+type Game struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Summary     string `json:"summary"`
+	ReleaseDate int64  `json:"first_release_date"`
+	Cover       int64  `json:"cover"`
+	UpdatedAt   int64  `json:"updated_at"`
+}
+
+// Platform is the cached subset of igdb.Platform fields the store persists.
+type Platform struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// Company is the cached subset of igdb.Company fields the store persists.
+type Company struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Country int    `json:"country"`
+}
+
+// ReleaseDate is the cached subset of igdb.ReleaseDate fields the store persists.
+type ReleaseDate struct {
+	ID         int64 `json:"id"`
+	GameID     int64 `json:"game"`
+	PlatformID int64 `json:"platform"`
+	Date       int64 `json:"date"`
+	Region     int   `json:"region"`
+}
@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// UpsertPlatform inserts p or, if its ID already exists, updates it in place.
+func (s *Store) UpsertPlatform(ctx context.Context, p Platform) error {
+	var query string
+	if s.driver == "postgres" {
+		query = `INSERT INTO platforms (id, name, abbreviation)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (id) DO UPDATE SET
+				name = EXCLUDED.name, abbreviation = EXCLUDED.abbreviation`
+	} else {
+		query = `INSERT INTO platforms (id, name, abbreviation)
+			VALUES (?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET
+				name = excluded.name, abbreviation = excluded.abbreviation`
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, p.ID, p.Name, p.Abbreviation); err != nil {
+		return fmt.Errorf("store: upserting platform %d: %w", p.ID, err)
+	}
+	return nil
+}
+
+// Platform fetches a single cached platform by ID, returning (nil, nil) on a
+// cache miss.
+func (s *Store) Platform(ctx context.Context, id int64) (*Platform, error) {
+	query := fmt.Sprintf(`SELECT id, name, abbreviation FROM platforms WHERE id = %s`, s.ph(1))
+
+	var p Platform
+	err := s.db.QueryRowContext(ctx, query, id).Scan(&p.ID, &p.Name, &p.Abbreviation)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: fetching platform %d: %w", id, err)
+	}
+	return &p, nil
+}
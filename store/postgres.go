@@ -0,0 +1,7 @@
+//go:build postgres
+
+package store
+
+// Blank-imported for its database/sql driver registration ("postgres"),
+// enabled by building with -tags postgres.
+import _ "github.com/lib/pq"
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// UpsertReleaseDate inserts rd or, if its ID already exists, updates it in place.
+func (s *Store) UpsertReleaseDate(ctx context.Context, rd ReleaseDate) error {
+	var query string
+	if s.driver == "postgres" {
+		query = `INSERT INTO release_dates (id, game_id, platform_id, date, region)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (id) DO UPDATE SET
+				game_id = EXCLUDED.game_id, platform_id = EXCLUDED.platform_id,
+				date = EXCLUDED.date, region = EXCLUDED.region`
+	} else {
+		query = `INSERT INTO release_dates (id, game_id, platform_id, date, region)
+			VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET
+				game_id = excluded.game_id, platform_id = excluded.platform_id,
+				date = excluded.date, region = excluded.region`
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, rd.ID, rd.GameID, rd.PlatformID, rd.Date, rd.Region); err != nil {
+		return fmt.Errorf("store: upserting release date %d: %w", rd.ID, err)
+	}
+	return nil
+}
+
+// ReleaseDatesForGame returns the cached per-platform release dates for a
+// single game.
+func (s *Store) ReleaseDatesForGame(ctx context.Context, gameID int64) ([]ReleaseDate, error) {
+	query := fmt.Sprintf(`SELECT id, game_id, platform_id, date, region
+		FROM release_dates WHERE game_id = %s ORDER BY date ASC`, s.ph(1))
+
+	rows, err := s.db.QueryContext(ctx, query, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("store: fetching release dates for game %d: %w", gameID, err)
+	}
+	defer rows.Close()
+
+	var dates []ReleaseDate
+	for rows.Next() {
+		var rd ReleaseDate
+		if err := rows.Scan(&rd.ID, &rd.GameID, &rd.PlatformID, &rd.Date, &rd.Region); err != nil {
+			return nil, fmt.Errorf("store: scanning release date row: %w", err)
+		}
+		dates = append(dates, rd)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("store: iterating release date rows: %w", err)
+	}
+	return dates, nil
+}
@@ -0,0 +1,7 @@
+//go:build !postgres
+
+package store
+
+// Blank-imported for its database/sql driver registration ("sqlite3"), the
+// default backend. Build with -tags postgres to swap in Postgres instead.
+import _ "github.com/mattn/go-sqlite3"
@@ -0,0 +1,87 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// Store is a persistent local cache of IGDB games, platforms, companies, and
+// release dates, backed by SQLite (default) or Postgres (build with -tags
+// postgres).
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+// Open opens a Store using driverName ("sqlite3" or "postgres") and dsn,
+// creating its schema if it doesn't already exist.
+func Open(driverName, dsn string) (*Store, error) {
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("store: opening %s database: %w", driverName, err)
+	}
+	if driverName == "sqlite3" {
+		// The collector upserts concurrently with request handlers caching
+		// games on every search. A single connection serializes all access
+		// through database/sql's own connection pool instead of the SQLite
+		// file lock, so concurrent writers queue rather than contend for
+		// the lock.
+		db.SetMaxOpenConns(1)
+	}
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("store: connecting to %s database: %w", driverName, err)
+	}
+
+	s := &Store{db: db, driver: driverName}
+	if err := s.migrate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close releases the underlying database connection.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) migrate() error {
+	const schema = `
+CREATE TABLE IF NOT EXISTS games (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	summary TEXT,
+	first_release_date INTEGER,
+	cover INTEGER,
+	updated_at INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS platforms (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	abbreviation TEXT
+);
+CREATE TABLE IF NOT EXISTS companies (
+	id INTEGER PRIMARY KEY,
+	name TEXT NOT NULL,
+	country INTEGER
+);
+CREATE TABLE IF NOT EXISTS release_dates (
+	id INTEGER PRIMARY KEY,
+	game_id INTEGER NOT NULL,
+	platform_id INTEGER,
+	date INTEGER,
+	region INTEGER
+);
+`
+	if _, err := s.db.Exec(schema); err != nil {
+		return fmt.Errorf("store: migrating schema: %w", err)
+	}
+	return nil
+}
+
+// ph returns the nth bind placeholder for the store's driver dialect.
+func (s *Store) ph(n int) string {
+	if s.driver == "postgres" {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
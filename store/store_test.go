@@ -0,0 +1,15 @@
+package store
+
+import "testing"
+
+func TestPlaceholderDialect(t *testing.T) {
+	sqlite := &Store{driver: "sqlite3"}
+	if got := sqlite.ph(1); got != "?" {
+		t.Errorf("sqlite3 ph(1) = %q, want %q", got, "?")
+	}
+
+	postgres := &Store{driver: "postgres"}
+	if got := postgres.ph(2); got != "$2" {
+		t.Errorf("postgres ph(2) = %q, want %q", got, "$2")
+	}
+}
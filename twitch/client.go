@@ -0,0 +1,178 @@
+package twitch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/larson-carter/IGDB-Wrapper/igdb"
+)
+
+// baseURL is the Twitch Helix API root.
+const baseURL = "https://api.twitch.tv/helix"
+
+// Client is a Twitch Helix API client. It shares its OAuth token with an
+// igdb.Client, since the same Twitch app credentials authenticate both APIs.
+type Client struct {
+	ClientID string
+	Token    *igdb.TokenSource
+
+	httpClient *resty.Client
+}
+
+// NewClient builds a Helix client for the given Twitch app client ID, reusing
+// an existing token source (e.g. an igdb.Client's Token) so both APIs share
+// one cached OAuth token.
+func NewClient(clientID string, token *igdb.TokenSource) *Client {
+	return &Client{
+		ClientID:   clientID,
+		Token:      token,
+		httpClient: resty.New(),
+	}
+}
+
+// envelope mirrors the {"data": [...]} wrapper every Helix endpoint returns.
+type envelope struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// apiError mirrors the {"error","status","message"} body Helix returns in
+// place of an envelope when a request fails.
+type apiError struct {
+	Error   string `json:"error"`
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// checkResponse returns an error describing resp if it represents a Helix
+// failure, so callers never unmarshal an error payload as if it were a
+// successful empty result.
+func checkResponse(resp *resty.Response, endpoint string) error {
+	if !resp.IsError() {
+		return nil
+	}
+
+	var apiErr apiError
+	if err := json.Unmarshal(resp.Body(), &apiErr); err == nil && apiErr.Message != "" {
+		return fmt.Errorf("twitch: %s: %d %s: %s", endpoint, apiErr.Status, apiErr.Error, apiErr.Message)
+	}
+
+	return fmt.Errorf("twitch: %s: request failed with status %d", endpoint, resp.StatusCode())
+}
+
+// get issues a GET against a Helix endpoint and decodes its "data" array
+// into out, which must be a pointer to a slice of the endpoint's result type.
+func (c *Client) get(ctx context.Context, endpoint string, query url.Values, out interface{}) error {
+	accessToken, err := c.Token.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("twitch: fetching access token: %w", err)
+	}
+
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Client-ID", c.ClientID).
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", accessToken)).
+		SetQueryParamsFromValues(query).
+		Get(fmt.Sprintf("%s/%s", baseURL, endpoint))
+	if err != nil {
+		return fmt.Errorf("twitch: requesting %s: %w", endpoint, err)
+	}
+	if err := checkResponse(resp, endpoint); err != nil {
+		return err
+	}
+
+	var env envelope
+	if err := json.Unmarshal(resp.Body(), &env); err != nil {
+		return fmt.Errorf("twitch: decoding %s response: %w", endpoint, err)
+	}
+
+	if err := json.Unmarshal(env.Data, out); err != nil {
+		return fmt.Errorf("twitch: decoding %s data: %w", endpoint, err)
+	}
+
+	return nil
+}
+
+// GetUsers looks up users by login name.
+func (c *Client) GetUsers(ctx context.Context, logins []string) ([]User, error) {
+	params := url.Values{}
+	for _, login := range logins {
+		params.Add("login", login)
+	}
+
+	var users []User
+	if err := c.get(ctx, "users", params, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// GetStreams looks up currently live streams by broadcaster login.
+func (c *Client) GetStreams(ctx context.Context, userLogins []string) ([]Stream, error) {
+	params := url.Values{}
+	for _, login := range userLogins {
+		params.Add("user_login", login)
+	}
+
+	var streams []Stream
+	if err := c.get(ctx, "streams", params, &streams); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+// GetChannels looks up channel info by broadcaster ID.
+func (c *Client) GetChannels(ctx context.Context, broadcasterIDs []string) ([]Channel, error) {
+	params := url.Values{}
+	for _, id := range broadcasterIDs {
+		params.Add("broadcaster_id", id)
+	}
+
+	var channels []Channel
+	if err := c.get(ctx, "channels", params, &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// GetFollowers lists the followers of a broadcaster's channel.
+func (c *Client) GetFollowers(ctx context.Context, broadcasterID string) ([]Follower, error) {
+	params := url.Values{}
+	params.Add("broadcaster_id", broadcasterID)
+
+	var followers []Follower
+	if err := c.get(ctx, "channels/followers", params, &followers); err != nil {
+		return nil, err
+	}
+	return followers, nil
+}
+
+// SearchCategories searches Helix games/categories by name.
+func (c *Client) SearchCategories(ctx context.Context, query string) ([]Category, error) {
+	params := url.Values{}
+	params.Add("query", query)
+
+	var categories []Category
+	if err := c.get(ctx, "search/categories", params, &categories); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// GetGames looks up Helix's own game records by IGDB game ID, letting a
+// caller bridge an igdb.Game to its Twitch category.
+func (c *Client) GetGames(ctx context.Context, igdbIDs []string) ([]Game, error) {
+	params := url.Values{}
+	for _, id := range igdbIDs {
+		params.Add("igdb_id", id)
+	}
+
+	var games []Game
+	if err := c.get(ctx, "games", params, &games); err != nil {
+		return nil, err
+	}
+	return games, nil
+}
@@ -0,0 +1,46 @@
+package twitch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestEnvelopeUnmarshalsDataArray(t *testing.T) {
+	body := []byte(`{"data":[{"id":"1","login":"shroud"}],"pagination":{}}`)
+
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		t.Fatalf("unmarshal envelope: %v", err)
+	}
+
+	var users []User
+	if err := json.Unmarshal(env.Data, &users); err != nil {
+		t.Fatalf("unmarshal users: %v", err)
+	}
+
+	if len(users) != 1 || users[0].Login != "shroud" {
+		t.Errorf("users = %+v, want one user with login shroud", users)
+	}
+}
+
+func TestCheckResponseReportsHelixAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		body, _ := json.Marshal(apiError{Error: "Unauthorized", Status: 401, Message: "Invalid OAuth token"})
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	resp, err := resty.New().R().Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+
+	if err := checkResponse(resp, "users"); err == nil {
+		t.Fatal("expected error for a 401 response, got nil")
+	}
+}
@@ -0,0 +1,60 @@
+package twitch
+
+// User represents a Twitch Helix user
+type User struct {
+	ID              string `json:"id"`
+	Login           string `json:"login"`
+	DisplayName     string `json:"display_name"`
+	Type            string `json:"type"`
+	BroadcasterType string `json:"broadcaster_type"`
+	Description     string `json:"description"`
+	ProfileImageURL string `json:"profile_image_url"`
+}
+
+// Stream represents a live Twitch Helix stream
+type Stream struct {
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	UserLogin   string `json:"user_login"`
+	UserName    string `json:"user_name"`
+	GameID      string `json:"game_id"`
+	GameName    string `json:"game_name"`
+	Title       string `json:"title"`
+	ViewerCount int    `json:"viewer_count"`
+	StartedAt   string `json:"started_at"`
+	Language    string `json:"language"`
+}
+
+// Channel represents a Twitch Helix channel's current broadcaster info
+type Channel struct {
+	BroadcasterID    string `json:"broadcaster_id"`
+	BroadcasterLogin string `json:"broadcaster_login"`
+	BroadcasterName  string `json:"broadcaster_name"`
+	GameID           string `json:"game_id"`
+	GameName         string `json:"game_name"`
+	Title            string `json:"title"`
+	Language         string `json:"broadcaster_language"`
+}
+
+// Follower represents a single follower relationship on a channel
+type Follower struct {
+	UserID     string `json:"user_id"`
+	UserLogin  string `json:"user_login"`
+	UserName   string `json:"user_name"`
+	FollowedAt string `json:"followed_at"`
+}
+
+// Category represents a Twitch Helix game/category search result
+type Category struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	BoxArtURL string `json:"box_art_url"`
+}
+
+// Game represents a Twitch Helix game, which can be looked up by IGDB ID
+type Game struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	BoxArtURL string `json:"box_art_url"`
+	IGDBID    string `json:"igdb_id"`
+}
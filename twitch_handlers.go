@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/larson-carter/IGDB-Wrapper/twitch"
+)
+
+// csvParam splits a comma-separated query parameter into its values.
+func csvParam(r *http.Request, name string) []string {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
+}
+
+// writeJSON encodes v as the response body, reporting any encoding failure
+// as a 500.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, "Error encoding response: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// handleTwitchUsers handles requests to look up Twitch users by login
+func handleTwitchUsers(client *twitch.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logins := csvParam(r, "login")
+		if len(logins) == 0 {
+			http.Error(w, "Query parameter 'login' is required", http.StatusBadRequest)
+			return
+		}
+
+		users, err := client.GetUsers(r.Context(), logins)
+		if err != nil {
+			http.Error(w, "Error fetching users: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, users)
+	}
+}
+
+// handleTwitchStreams handles requests to look up live streams by broadcaster login
+func handleTwitchStreams(client *twitch.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logins := csvParam(r, "user_login")
+		if len(logins) == 0 {
+			http.Error(w, "Query parameter 'user_login' is required", http.StatusBadRequest)
+			return
+		}
+
+		streams, err := client.GetStreams(r.Context(), logins)
+		if err != nil {
+			http.Error(w, "Error fetching streams: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, streams)
+	}
+}
+
+// handleTwitchChannels handles requests to look up channel info by broadcaster ID
+func handleTwitchChannels(client *twitch.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		broadcasterIDs := csvParam(r, "broadcaster_id")
+		if len(broadcasterIDs) == 0 {
+			http.Error(w, "Query parameter 'broadcaster_id' is required", http.StatusBadRequest)
+			return
+		}
+
+		channels, err := client.GetChannels(r.Context(), broadcasterIDs)
+		if err != nil {
+			http.Error(w, "Error fetching channels: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, channels)
+	}
+}
+
+// handleTwitchFollowers handles requests to list a channel's followers
+func handleTwitchFollowers(client *twitch.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		broadcasterID := r.URL.Query().Get("broadcaster_id")
+		if broadcasterID == "" {
+			http.Error(w, "Query parameter 'broadcaster_id' is required", http.StatusBadRequest)
+			return
+		}
+
+		followers, err := client.GetFollowers(r.Context(), broadcasterID)
+		if err != nil {
+			http.Error(w, "Error fetching followers: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, followers)
+	}
+}
+
+// handleTwitchCategorySearch handles requests to search Helix games/categories by name
+func handleTwitchCategorySearch(client *twitch.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get("q")
+		if query == "" {
+			http.Error(w, "Query parameter 'q' is required", http.StatusBadRequest)
+			return
+		}
+
+		categories, err := client.SearchCategories(r.Context(), query)
+		if err != nil {
+			http.Error(w, "Error searching categories: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, categories)
+	}
+}
+
+// handleTwitchGames handles requests to look up Helix games by IGDB game ID
+func handleTwitchGames(client *twitch.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		igdbIDs := csvParam(r, "igdb_id")
+		if len(igdbIDs) == 0 {
+			http.Error(w, "Query parameter 'igdb_id' is required", http.StatusBadRequest)
+			return
+		}
+
+		games, err := client.GetGames(r.Context(), igdbIDs)
+		if err != nil {
+			http.Error(w, "Error fetching games: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		writeJSON(w, games)
+	}
+}